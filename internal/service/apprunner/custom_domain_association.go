@@ -6,176 +6,578 @@ package apprunner
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/apprunner"
 	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
-	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"golang.org/x/net/idna"
+	"golang.org/x/net/publicsuffix"
 )
 
-// @SDKResource("aws_apprunner_custom_domain_association", name="Custom Domain Association")
-func resourceCustomDomainAssociation() *schema.Resource {
-	return &schema.Resource{
-		CreateWithoutTimeout: resourceCustomDomainAssociationCreate,
-		ReadWithoutTimeout:   resourceCustomDomainAssociationRead,
-		DeleteWithoutTimeout: resourceCustomDomainAssociationDelete,
+// @FrameworkResource("aws_apprunner_custom_domain_association", name="Custom Domain Association")
+func newCustomDomainAssociationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &customDomainAssociationResource{}, nil
+}
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+type customDomainAssociationResource struct {
+	meta *conns.AWSClient
+}
+
+func (r *customDomainAssociationResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_apprunner_custom_domain_association"
+}
+
+func (r *customDomainAssociationResource) Configure(_ context.Context, request resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	r.meta = request.ProviderData.(*conns.AWSClient)
+}
 
-		Schema: map[string]*schema.Schema{
-			"certificate_validation_records": {
-				Type:     schema.TypeSet,
+func (r *customDomainAssociationResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
 				Computed: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"status": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"type": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"value": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"certificate_validation_records": schema.SetNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name":   schema.StringAttribute{Computed: true},
+						"status": schema.StringAttribute{Computed: true},
+						"type":   schema.StringAttribute{Computed: true},
+						"value":  schema.StringAttribute{Computed: true},
 					},
 				},
 			},
-			"dns_target": {
-				Type:     schema.TypeString,
+			"dns_target": schema.StringAttribute{
 				Computed: true,
 			},
-			"domain_name": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.StringLenBetween(1, 255),
+			"domain_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+					domainNameValidator{},
+				},
 			},
-			"enable_www_subdomain": {
-				Type:     schema.TypeBool,
+			"enable_www_subdomain": schema.BoolAttribute{
 				Optional: true,
-				Default:  true,
-				ForceNew: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"service_arn": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"service_arn": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+			"validation_dns_records": schema.MapAttribute{
+				ElementType: fwtypes.StringType,
+				Computed:    true,
 			},
-			"status": {
-				Type:     schema.TypeString,
+			"validation_zone_file": schema.StringAttribute{
 				Computed: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"route53_validation": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"create_records": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(true),
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.RequiresReplace(),
+							},
+						},
+						"ttl": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							Default:  int64default.StaticInt64(300),
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.RequiresReplace(),
+							},
+						},
+						"zone_id": schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
 	}
 }
 
-func resourceCustomDomainAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).AppRunnerClient(ctx)
+// ValidateConfig enforces plan-time rules that AWS App Runner would
+// otherwise only reject at apply time.
+//
+// NOTE: App Runner also rejects a second association whose eTLD+1 duplicates
+// an existing association's, but the Terraform plugin protocol only gives
+// ValidateConfig visibility into this resource instance's own
+// configuration, not sibling aws_apprunner_custom_domain_association
+// instances in the same plan. That cross-resource duplicate check can only
+// be enforced by App Runner itself at apply time.
+func (r *customDomainAssociationResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config customDomainAssociationResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if config.DomainName.IsNull() || config.DomainName.IsUnknown() || config.EnableWWWSubdomain.IsUnknown() {
+		return
+	}
+
+	domainName := strings.ToLower(config.DomainName.ValueString())
+
+	// enable_www_subdomain defaults to true, but ValidateConfig only sees the
+	// raw Config, not the defaulted Plan, so a null value here still means
+	// "true" to the user.
+	enableWWWSubdomain := config.EnableWWWSubdomain.IsNull() || config.EnableWWWSubdomain.ValueBool()
+
+	if strings.HasPrefix(domainName, "www.") && enableWWWSubdomain {
+		response.Diagnostics.AddAttributeWarning(
+			path.Root("enable_www_subdomain"),
+			"Redundant www Subdomain",
+			fmt.Sprintf("domain_name %q is already a www subdomain. App Runner will also try to associate %q, which it will reject.", domainName, "www."+domainName),
+		)
+	}
+}
 
-	domainName := d.Get("domain_name").(string)
-	serviceARN := d.Get("service_arn").(string)
+func (r *customDomainAssociationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data customDomainAssociationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.meta.AppRunnerClient(ctx)
+
+	domainName := data.DomainName.ValueString()
+	serviceARN := data.ServiceARN.ValueString()
 	id := customDomainAssociationCreateResourceID(domainName, serviceARN)
 	input := &apprunner.AssociateCustomDomainInput{
 		DomainName:         aws.String(domainName),
-		EnableWWWSubdomain: aws.Bool(d.Get("enable_www_subdomain").(bool)),
+		EnableWWWSubdomain: data.EnableWWWSubdomain.ValueBoolPointer(),
 		ServiceArn:         aws.String(serviceARN),
 	}
 
 	output, err := conn.AssociateCustomDomain(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("creating App Runner Custom Domain Association (%s): %s", id, err)
+		response.Diagnostics.AddError(fmt.Sprintf("creating App Runner Custom Domain Association (%s)", id), err.Error())
+		return
+	}
+
+	data.ID = fwtypes.StringValue(id)
+	data.DNSTarget = fwtypes.StringValue(aws.ToString(output.DNSTarget))
+
+	createTimeout, diags := data.Timeouts.Create(ctx, 5*time.Minute)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createStart := time.Now()
+	customDomain, err := waitCustomDomainAssociationCreated(ctx, conn, domainName, serviceARN, createTimeout)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for App Runner Custom Domain Association (%s) create", id), err.Error())
+		return
+	}
+
+	var route53Validations []route53ValidationResourceModel
+	response.Diagnostics.Append(data.Route53Validation.ElementsAs(ctx, &route53Validations, false)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	d.SetId(id)
-	d.Set("dns_target", output.DNSTarget)
+	if len(route53Validations) > 0 {
+		v := route53Validations[0]
+
+		if v.CreateRecords.ValueBool() {
+			route53Conn := r.meta.Route53Client(ctx)
+
+			if err := createCustomDomainValidationRecords(ctx, route53Conn, v.ZoneID.ValueString(), int32(v.TTL.ValueInt64()), customDomain.CertificateValidationRecords); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("creating Route 53 validation records for App Runner Custom Domain Association (%s)", id), err.Error())
+				return
+			}
+		}
+
+		// Both waits draw from the single `timeouts { create }` budget, so the
+		// second wait only gets what the first didn't already spend.
+		activeTimeout := createTimeout - time.Since(createStart)
+		if activeTimeout <= 0 {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for App Runner Custom Domain Association (%s) to become active", id), "timeout exceeded while creating Route 53 validation records")
+			return
+		}
+
+		customDomain, err = waitCustomDomainAssociationActive(ctx, conn, domainName, serviceARN, activeTimeout)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for App Runner Custom Domain Association (%s) to become active", id), err.Error())
+			return
+		}
+	}
 
-	if _, err := waitCustomDomainAssociationCreated(ctx, conn, domainName, serviceARN); err != nil {
-		return diag.Errorf("waiting for App Runner Custom Domain Association (%s) create: %s", d.Id(), err)
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, customDomain, serviceARN)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	return resourceCustomDomainAssociationRead(ctx, d, meta)
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
-func resourceCustomDomainAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).AppRunnerClient(ctx)
+func (r *customDomainAssociationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data customDomainAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.meta.AppRunnerClient(ctx)
+
+	domainName, serviceARN, err := customDomainAssociationParseResourceID(data.ID.ValueString())
 
-	domainName, serviceArn, err := customDomainAssociationParseResourceID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		return
 	}
 
-	customDomain, err := findCustomDomainByTwoPartKey(ctx, conn, domainName, serviceArn)
+	customDomain, err := findCustomDomainByTwoPartKey(ctx, conn, domainName, serviceARN)
 
-	if !d.IsNewResource() && tfresource.NotFound(err) {
-		log.Printf("[WARN] App Runner Custom Domain Association (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
+	if tfresource.NotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
 	}
 
 	if err != nil {
-		return diag.Errorf("reading App Runner Custom Domain Association (%s): %s", d.Id(), err)
+		response.Diagnostics.AddError(fmt.Sprintf("reading App Runner Custom Domain Association (%s)", data.ID.ValueString()), err.Error())
+		return
 	}
 
-	if err := d.Set("certificate_validation_records", flattenCustomDomainCertificateValidationRecords(customDomain.CertificateValidationRecords)); err != nil {
-		return diag.Errorf("setting certificate_validation_records: %s", err)
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, customDomain, serviceARN)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
-	d.Set("domain_name", customDomain.DomainName)
-	d.Set("enable_www_subdomain", customDomain.EnableWWWSubdomain)
-	d.Set("service_arn", serviceArn)
-	d.Set("status", customDomain.Status)
 
-	return nil
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
-func resourceCustomDomainAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).AppRunnerClient(ctx)
+func (r *customDomainAssociationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Every attribute is RequiresReplace, so the only plans that reach
+	// Update change `timeouts` or remove the `route53_validation` block;
+	// nothing to send to App Runner.
+	var data customDomainAssociationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *customDomainAssociationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data customDomainAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.meta.AppRunnerClient(ctx)
+
+	domainName, serviceARN, err := customDomainAssociationParseResourceID(data.ID.ValueString())
 
-	domainName, serviceARN, err := customDomainAssociationParseResourceID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		return
 	}
 
-	log.Printf("[INFO] Deleting App Runner Custom Domain Association: %s", d.Id())
 	_, err = conn.DisassociateCustomDomain(ctx, &apprunner.DisassociateCustomDomainInput{
 		DomainName: aws.String(domainName),
 		ServiceArn: aws.String(serviceARN),
 	})
 
 	if errs.IsA[*types.ResourceNotFoundException](err) {
-		return nil
+		return
 	}
 
 	if err != nil {
-		return diag.Errorf("deleting App Runner Custom Domain Association (%s): %s", d.Id(), err)
+		response.Diagnostics.AddError(fmt.Sprintf("deleting App Runner Custom Domain Association (%s)", data.ID.ValueString()), err.Error())
+		return
 	}
 
 	if _, err := waitCustomDomainAssociationDeleted(ctx, conn, domainName, serviceARN); err != nil {
-		return diag.Errorf("waiting for App Runner Custom Domain Association (%s) delete: %s", d.Id(), err)
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for App Runner Custom Domain Association (%s) delete", data.ID.ValueString()), err.Error())
 	}
+}
 
-	return nil
+func (r *customDomainAssociationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+type customDomainAssociationResourceModel struct {
+	CertificateValidationRecords fwtypes.Set    `tfsdk:"certificate_validation_records"`
+	DNSTarget                    fwtypes.String `tfsdk:"dns_target"`
+	DomainName                   fwtypes.String `tfsdk:"domain_name"`
+	EnableWWWSubdomain           fwtypes.Bool   `tfsdk:"enable_www_subdomain"`
+	ID                           fwtypes.String `tfsdk:"id"`
+	Route53Validation            fwtypes.List   `tfsdk:"route53_validation"`
+	ServiceARN                   fwtypes.String `tfsdk:"service_arn"`
+	Status                       fwtypes.String `tfsdk:"status"`
+	Timeouts                     timeouts.Value `tfsdk:"timeouts"`
+	ValidationDNSRecords         fwtypes.Map    `tfsdk:"validation_dns_records"`
+	ValidationZoneFile           fwtypes.String `tfsdk:"validation_zone_file"`
+}
+
+type route53ValidationResourceModel struct {
+	CreateRecords fwtypes.Bool   `tfsdk:"create_records"`
+	TTL           fwtypes.Int64  `tfsdk:"ttl"`
+	ZoneID        fwtypes.String `tfsdk:"zone_id"`
+}
+
+type certificateValidationRecordResourceModel struct {
+	Name   fwtypes.String `tfsdk:"name"`
+	Status fwtypes.String `tfsdk:"status"`
+	Type   fwtypes.String `tfsdk:"type"`
+	Value  fwtypes.String `tfsdk:"value"`
+}
+
+var certificateValidationRecordAttrTypes = map[string]attr.Type{
+	"name":   fwtypes.StringType,
+	"status": fwtypes.StringType,
+	"type":   fwtypes.StringType,
+	"value":  fwtypes.StringType,
+}
+
+func (m *customDomainAssociationResourceModel) refreshFromOutput(ctx context.Context, apiObject *types.CustomDomain, serviceARN string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.DomainName = fwtypes.StringValue(aws.ToString(apiObject.DomainName))
+	m.EnableWWWSubdomain = fwtypes.BoolPointerValue(apiObject.EnableWWWSubdomain)
+	m.ServiceARN = fwtypes.StringValue(serviceARN)
+	m.Status = fwtypes.StringValue(string(apiObject.Status))
+
+	records, d := flattenCertificateValidationRecords(ctx, apiObject.CertificateValidationRecords)
+	diags.Append(d...)
+	m.CertificateValidationRecords = records
+
+	validationDNSRecords, d := flattenValidationDNSRecords(ctx, apiObject.CertificateValidationRecords)
+	diags.Append(d...)
+	m.ValidationDNSRecords = validationDNSRecords
+
+	var route53Validations []route53ValidationResourceModel
+	diags.Append(m.Route53Validation.ElementsAs(ctx, &route53Validations, false)...)
+
+	ttl := int32(validationZoneFileDefaultTTL)
+	if len(route53Validations) > 0 {
+		ttl = int32(route53Validations[0].TTL.ValueInt64())
+	}
+
+	m.ValidationZoneFile = fwtypes.StringValue(buildValidationZoneFile(apiObject.CertificateValidationRecords, ttl))
+
+	return diags
+}
+
+// validationDNSRecordsMap returns the CNAME validation records keyed by
+// record name, for use with Terraform's `for_each` (e.g. against
+// aws_route53_record).
+func validationDNSRecordsMap(apiObjects []types.CertificateValidationRecord) map[string]string {
+	elements := make(map[string]string, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		elements[aws.ToString(apiObject.Name)] = aws.ToString(apiObject.Value)
+	}
+
+	return elements
+}
+
+func flattenValidationDNSRecords(ctx context.Context, apiObjects []types.CertificateValidationRecord) (fwtypes.Map, diag.Diagnostics) {
+	return fwtypes.MapValueFrom(ctx, fwtypes.StringType, validationDNSRecordsMap(apiObjects))
+}
+
+// validationZoneFileDefaultTTL matches the route53_validation.ttl schema
+// default, for associations that don't configure a route53_validation block.
+const validationZoneFileDefaultTTL = 300
+
+// buildValidationZoneFile renders the certificate validation records as a
+// BIND-style zone file fragment, for users who validate the domain with a
+// DNS provider other than Route 53. ttl should reflect the TTL actually used
+// for the Route 53 records (route53_validation.ttl), when configured, so the
+// zone file agrees with the real records.
+func buildValidationZoneFile(apiObjects []types.CertificateValidationRecord, ttl int32) string {
+	if len(apiObjects) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "$TTL %d\n", ttl)
+
+	for _, apiObject := range apiObjects {
+		recordType := aws.ToString(apiObject.Type)
+		if recordType == "" {
+			recordType = "CNAME"
+		}
+
+		fmt.Fprintf(&sb, "%s IN %s %s\n", zoneFileName(aws.ToString(apiObject.Name)), recordType, zoneFileName(aws.ToString(apiObject.Value)))
+	}
+
+	return sb.String()
+}
+
+// zoneFileName converts a (possibly internationalized) DNS name to the
+// ASCII, fully-qualified form a zone file expects.
+func zoneFileName(name string) string {
+	if ascii, err := idna.ToASCII(name); err == nil {
+		name = ascii
+	}
+
+	if name == "" || strings.HasSuffix(name, ".") {
+		return name
+	}
+
+	return name + "."
+}
+
+func flattenCertificateValidationRecords(ctx context.Context, apiObjects []types.CertificateValidationRecord) (fwtypes.Set, diag.Diagnostics) {
+	elementType := fwtypes.ObjectType{AttrTypes: certificateValidationRecordAttrTypes}
+
+	if len(apiObjects) == 0 {
+		return fwtypes.SetValueMust(elementType, []attr.Value{}), nil
+	}
+
+	tfObjects := make([]certificateValidationRecordResourceModel, len(apiObjects))
+
+	for i, apiObject := range apiObjects {
+		tfObjects[i] = certificateValidationRecordResourceModel{
+			Name:   fwtypes.StringValue(aws.ToString(apiObject.Name)),
+			Status: fwtypes.StringValue(string(apiObject.Status)),
+			Type:   fwtypes.StringValue(aws.ToString(apiObject.Type)),
+			Value:  fwtypes.StringValue(aws.ToString(apiObject.Value)),
+		}
+	}
+
+	return fwtypes.SetValueFrom(ctx, elementType, tfObjects)
+}
+
+// domainNameValidator rejects domain names that cannot be IDNA-encoded or
+// that have no registrable eTLD+1 (e.g. a bare public suffix like "co.uk").
+// Subdomains such as "app.example.com" are accepted.
+type domainNameValidator struct{}
+
+func (v domainNameValidator) Description(_ context.Context) string {
+	return "domain name must be a valid, IDNA-encodable, registrable DNS name"
+}
+
+func (v domainNameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v domainNameValidator) ValidateString(_ context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	domainName := request.ConfigValue.ValueString()
+
+	ascii, err := idna.Lookup.ToASCII(domainName)
+	if err != nil {
+		response.Diagnostics.AddAttributeError(request.Path, "Invalid Domain Name", fmt.Sprintf("%q is not a valid domain name: %s", domainName, err))
+		return
+	}
+
+	// publicsuffix's table is keyed on ASCII/punycode labels, so an IDN like
+	// "例え.テスト" must be checked via its ASCII form, not the raw Unicode value.
+	if _, err := publicsuffix.EffectiveTLDPlusOne(strings.TrimSuffix(ascii, ".")); err != nil {
+		response.Diagnostics.AddAttributeError(request.Path, "Invalid Domain Name", fmt.Sprintf("%q is not a registrable domain name: %s", domainName, err))
+	}
+}
+
+func createCustomDomainValidationRecords(ctx context.Context, conn *route53.Client, zoneID string, ttl int32, records []types.CertificateValidationRecord) error {
+	changes := make([]route53types.Change, 0, len(records))
+
+	for _, record := range records {
+		if record.Type == nil || aws.ToString(record.Type) != "CNAME" {
+			continue
+		}
+
+		changes = append(changes, route53types.Change{
+			Action: route53types.ChangeActionUpsert,
+			ResourceRecordSet: &route53types.ResourceRecordSet{
+				Name: record.Name,
+				Type: route53types.RRTypeCname,
+				TTL:  aws.Int64(int64(ttl)),
+				ResourceRecords: []route53types.ResourceRecord{
+					{Value: record.Value},
+				},
+			},
+		})
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	input := &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+		ChangeBatch: &route53types.ChangeBatch{
+			Changes: changes,
+		},
+	}
+
+	_, err := conn.ChangeResourceRecordSets(ctx, input)
+
+	return err
 }
 
 const customDomainAssociationIDSeparator = ","
@@ -282,10 +684,7 @@ func statusCustomDomain(ctx context.Context, conn *apprunner.Client, domainName,
 	}
 }
 
-func waitCustomDomainAssociationCreated(ctx context.Context, conn *apprunner.Client, domainName, serviceARN string) (*types.CustomDomain, error) {
-	const (
-		timeout = 5 * time.Minute
-	)
+func waitCustomDomainAssociationCreated(ctx context.Context, conn *apprunner.Client, domainName, serviceARN string, timeout time.Duration) (*types.CustomDomain, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{customDomainAssociationStatusCreating},
 		Target:  []string{customDomainAssociationStatusPendingCertificateDNSValidation, customDomainAssociationStatusBindingCertificate},
@@ -302,6 +701,23 @@ func waitCustomDomainAssociationCreated(ctx context.Context, conn *apprunner.Cli
 	return nil, err
 }
 
+func waitCustomDomainAssociationActive(ctx context.Context, conn *apprunner.Client, domainName, serviceARN string, timeout time.Duration) (*types.CustomDomain, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{customDomainAssociationStatusCreating, customDomainAssociationStatusPendingCertificateDNSValidation, customDomainAssociationStatusBindingCertificate},
+		Target:  []string{customDomainAssociationStatusActive},
+		Refresh: statusCustomDomain(ctx, conn, domainName, serviceARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.CustomDomain); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitCustomDomainAssociationDeleted(ctx context.Context, conn *apprunner.Client, domainName, serviceARN string) (*types.CustomDomain, error) {
 	const (
 		timeout = 5 * time.Minute
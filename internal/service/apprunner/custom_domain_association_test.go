@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apprunner
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+)
+
+func TestValidationDNSRecordsMap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		records []types.CertificateValidationRecord
+		want    map[string]string
+	}{
+		"empty": {
+			records: nil,
+			want:    map[string]string{},
+		},
+		"multiple records": {
+			records: []types.CertificateValidationRecord{
+				{
+					Name:  aws.String("_abc123.example.com"),
+					Type:  aws.String("CNAME"),
+					Value: aws.String("_xyz789.acm-validations.aws."),
+				},
+				{
+					Name:  aws.String("_abc123.www.example.com"),
+					Type:  aws.String("CNAME"),
+					Value: aws.String("_xyz790.acm-validations.aws."),
+				},
+			},
+			want: map[string]string{
+				"_abc123.example.com":     "_xyz789.acm-validations.aws.",
+				"_abc123.www.example.com": "_xyz790.acm-validations.aws.",
+			},
+		},
+		"IDN name": {
+			records: []types.CertificateValidationRecord{
+				{
+					Name:  aws.String("_abc123.例え.テスト"),
+					Type:  aws.String("CNAME"),
+					Value: aws.String("_xyz789.acm-validations.aws."),
+				},
+			},
+			want: map[string]string{
+				"_abc123.例え.テスト": "_xyz789.acm-validations.aws.",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validationDNSRecordsMap(testCase.records)
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("validationDNSRecordsMap() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestBuildValidationZoneFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty records produce an empty zone file", func(t *testing.T) {
+		t.Parallel()
+
+		if got := buildValidationZoneFile(nil, validationZoneFileDefaultTTL); got != "" {
+			t.Errorf("buildValidationZoneFile(nil) = %q, want empty string", got)
+		}
+	})
+
+	t.Run("multiple records are rendered in order with a $TTL directive", func(t *testing.T) {
+		t.Parallel()
+
+		records := []types.CertificateValidationRecord{
+			{
+				Name:  aws.String("_abc123.example.com"),
+				Type:  aws.String("CNAME"),
+				Value: aws.String("_xyz789.acm-validations.aws"),
+			},
+			{
+				Name:  aws.String("_abc123.www.example.com"),
+				Type:  aws.String("CNAME"),
+				Value: aws.String("_xyz790.acm-validations.aws"),
+			},
+		}
+
+		got := buildValidationZoneFile(records, 600)
+		want := strings.Join([]string{
+			"$TTL 600",
+			"_abc123.example.com. IN CNAME _xyz789.acm-validations.aws.",
+			"_abc123.www.example.com. IN CNAME _xyz790.acm-validations.aws.",
+			"",
+		}, "\n")
+
+		if got != want {
+			t.Errorf("buildValidationZoneFile() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("IDN names are rendered as ASCII/punycode", func(t *testing.T) {
+		t.Parallel()
+
+		records := []types.CertificateValidationRecord{
+			{
+				Name:  aws.String("_abc123.例え.テスト"),
+				Type:  aws.String("CNAME"),
+				Value: aws.String("_xyz789.acm-validations.aws"),
+			},
+		}
+
+		got := buildValidationZoneFile(records, validationZoneFileDefaultTTL)
+
+		if strings.Contains(got, "例え") {
+			t.Errorf("buildValidationZoneFile() = %q, want ASCII-encoded name", got)
+		}
+
+		if !strings.Contains(got, "xn--") {
+			t.Errorf("buildValidationZoneFile() = %q, want punycode-encoded name", got)
+		}
+	})
+}
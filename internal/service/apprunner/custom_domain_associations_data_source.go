@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apprunner
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_apprunner_custom_domain_associations", name="Custom Domain Associations")
+func dataSourceCustomDomainAssociations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCustomDomainAssociationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"custom_domain_associations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_validation_records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"dns_target": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enable_www_subdomain": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"service_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceCustomDomainAssociationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerClient(ctx)
+
+	serviceARN := d.Get("service_arn").(string)
+	input := &apprunner.DescribeCustomDomainsInput{
+		ServiceArn: aws.String(serviceARN),
+	}
+
+	var dnsTarget string
+	var customDomains []*types.CustomDomain
+
+	err := forEachCustomDomainPage(ctx, conn, input, func(page *apprunner.DescribeCustomDomainsOutput) {
+		dnsTarget = aws.ToString(page.DNSTarget)
+
+		for _, v := range page.CustomDomains {
+			v := v
+			customDomains = append(customDomains, &v)
+		}
+	})
+
+	if err != nil {
+		return diag.Errorf("reading App Runner Custom Domain Associations (%s): %s", serviceARN, err)
+	}
+
+	d.SetId(serviceARN)
+	d.Set("custom_domain_associations", flattenCustomDomains(customDomains, dnsTarget))
+
+	return nil
+}
+
+func flattenCustomDomains(apiObjects []*types.CustomDomain, dnsTarget string) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"certificate_validation_records": flattenCustomDomainCertificateValidationRecords(apiObject.CertificateValidationRecords),
+			"dns_target":                     dnsTarget,
+			"domain_name":                    aws.ToString(apiObject.DomainName),
+			"enable_www_subdomain":           aws.ToBool(apiObject.EnableWWWSubdomain),
+			"status":                         string(apiObject.Status),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
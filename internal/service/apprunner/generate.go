@@ -0,0 +1,8 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:generate go run ../../generate/tags/main.go -ServiceTagsSlice -KVTValues -ListTags -ListTagsOp=ListTagsForResource -ListTagsInIDElem=ResourceArn -ServiceTagsMap -TagOp=TagResource -TagInIDElem=ResourceArn -TagInTagsElem=Tags -UntagOp=UntagResource -UntagInTagsElem=TagKeys -UpdateTags
+//go:generate go run ../../generate/tagstests/main.go -AWSService=apprunner
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package apprunner
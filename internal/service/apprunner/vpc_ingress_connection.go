@@ -0,0 +1,327 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apprunner
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_apprunner_vpc_ingress_connection", name="VPC Ingress Connection")
+// @Tags(identifierAttribute="arn")
+func resourceVPCIngressConnection() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVPCIngressConnectionCreate,
+		ReadWithoutTimeout:   resourceVPCIngressConnectionRead,
+		UpdateWithoutTimeout: resourceVPCIngressConnectionUpdate,
+		DeleteWithoutTimeout: resourceVPCIngressConnectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ingress_vpc_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"vpc_endpoint_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(4, 40),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9\-_]{3,39}$`), "must begin with an alphanumeric character and contain only alphanumeric characters, hyphens, and underscores"),
+				),
+			},
+			"service_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceVPCIngressConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerClient(ctx)
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &apprunner.CreateVpcIngressConnectionInput{
+		IngressVpcConfiguration:  expandIngressVPCConfiguration(d.Get("ingress_vpc_configuration").([]interface{})),
+		ServiceArn:               aws.String(d.Get("service_arn").(string)),
+		VpcIngressConnectionName: aws.String(name),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = svcTags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateVpcIngressConnection(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating App Runner VPC Ingress Connection (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.VpcIngressConnection.VpcIngressConnectionArn))
+
+	if _, err := waitVPCIngressConnectionCreated(ctx, conn, d.Id()); err != nil {
+		return diag.Errorf("waiting for App Runner VPC Ingress Connection (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceVPCIngressConnectionRead(ctx, d, meta)
+}
+
+func resourceVPCIngressConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerClient(ctx)
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	vpcIngressConnection, err := findVPCIngressConnectionByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] App Runner VPC Ingress Connection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading App Runner VPC Ingress Connection (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", vpcIngressConnection.VpcIngressConnectionArn)
+	d.Set("domain_name", vpcIngressConnection.DomainName)
+	if err := d.Set("ingress_vpc_configuration", flattenIngressVPCConfiguration(vpcIngressConnection.IngressVpcConfiguration)); err != nil {
+		return diag.Errorf("setting ingress_vpc_configuration: %s", err)
+	}
+	d.Set("name", vpcIngressConnection.VpcIngressConnectionName)
+	d.Set("service_arn", vpcIngressConnection.ServiceArn)
+	d.Set("status", vpcIngressConnection.Status)
+
+	tags, err := listTags(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for App Runner VPC Ingress Connection (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map())
+	d.Set("tags_all", tags.Map())
+
+	return nil
+}
+
+func resourceVPCIngressConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerClient(ctx)
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := updateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating tags for App Runner VPC Ingress Connection (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceVPCIngressConnectionRead(ctx, d, meta)
+}
+
+func resourceVPCIngressConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerClient(ctx)
+
+	log.Printf("[INFO] Deleting App Runner VPC Ingress Connection: %s", d.Id())
+	_, err := conn.DeleteVpcIngressConnection(ctx, &apprunner.DeleteVpcIngressConnectionInput{
+		VpcIngressConnectionArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting App Runner VPC Ingress Connection (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitVPCIngressConnectionDeleted(ctx, conn, d.Id()); err != nil {
+		return diag.Errorf("waiting for App Runner VPC Ingress Connection (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func findVPCIngressConnectionByARN(ctx context.Context, conn *apprunner.Client, arn string) (*types.VpcIngressConnection, error) {
+	input := &apprunner.DescribeVpcIngressConnectionInput{
+		VpcIngressConnectionArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeVpcIngressConnection(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.VpcIngressConnection == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	vpcIngressConnection := output.VpcIngressConnection
+
+	if status := vpcIngressConnection.Status; status == types.VpcIngressConnectionStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return vpcIngressConnection, nil
+}
+
+func statusVPCIngressConnection(ctx context.Context, conn *apprunner.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findVPCIngressConnectionByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitVPCIngressConnectionCreated(ctx context.Context, conn *apprunner.Client, arn string) (*types.VpcIngressConnection, error) {
+	const (
+		timeout = 5 * time.Minute
+	)
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{string(types.VpcIngressConnectionStatusPendingCreation)},
+		Target:  []string{string(types.VpcIngressConnectionStatusAvailable)},
+		Refresh: statusVPCIngressConnection(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VpcIngressConnection); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitVPCIngressConnectionDeleted(ctx context.Context, conn *apprunner.Client, arn string) (*types.VpcIngressConnection, error) {
+	const (
+		timeout = 5 * time.Minute
+	)
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{string(types.VpcIngressConnectionStatusAvailable), string(types.VpcIngressConnectionStatusPendingDeletion)},
+		Target:  []string{},
+		Refresh: statusVPCIngressConnection(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VpcIngressConnection); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandIngressVPCConfiguration(tfList []interface{}) *types.IngressVpcConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	apiObject := &types.IngressVpcConfiguration{}
+
+	if v, ok := tfMap["vpc_id"].(string); ok && v != "" {
+		apiObject.VpcId = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc_endpoint_id"].(string); ok && v != "" {
+		apiObject.VpcEndpointId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenIngressVPCConfiguration(apiObject *types.IngressVpcConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"vpc_id":          aws.ToString(apiObject.VpcId),
+		"vpc_endpoint_id": aws.ToString(apiObject.VpcEndpointId),
+	}
+
+	return []interface{}{tfMap}
+}